@@ -0,0 +1,165 @@
+package reaper
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Authenticator applies credentials to an outgoing request. Implementations must be safe for
+// concurrent use, since Client may apply the same Authenticator to requests from multiple
+// goroutines (e.g. GetClusters).
+type Authenticator interface {
+	Apply(req *http.Request) error
+}
+
+// AuthRefresher is implemented by Authenticators that can refresh cached credentials, e.g. after
+// a 401 response. Client.do calls Refresh at most once per request and retries the request when
+// it succeeds.
+type AuthRefresher interface {
+	Refresh(ctx context.Context) error
+}
+
+// BasicAuthenticator applies HTTP Basic authentication.
+type BasicAuthenticator struct {
+	Username string
+	Password string
+}
+
+func NewBasicAuthenticator(username, password string) *BasicAuthenticator {
+	return &BasicAuthenticator{Username: username, Password: password}
+}
+
+func (a *BasicAuthenticator) Apply(req *http.Request) error {
+	req.SetBasicAuth(a.Username, a.Password)
+	return nil
+}
+
+// BearerTokenAuthenticator applies a fixed bearer token, e.g. a token obtained out of band.
+type BearerTokenAuthenticator struct {
+	Token string
+}
+
+func NewBearerTokenAuthenticator(token string) *BearerTokenAuthenticator {
+	return &BearerTokenAuthenticator{Token: token}
+}
+
+func (a *BearerTokenAuthenticator) Apply(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// JWTAuthenticator logs in against Reaper's Shiro-backed /login endpoint, exchanges the resulting
+// session for a JWT from /jwt, caches it, and refreshes it on demand (Client.do calls Refresh
+// after a 401).
+type JWTAuthenticator struct {
+	Username string
+	Password string
+
+	baseURL    *url.URL
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	token string
+}
+
+func NewJWTAuthenticator(baseURL *url.URL, username, password string, httpClient *http.Client) *JWTAuthenticator {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	if httpClient.Jar == nil {
+		// /login authenticates a Shiro session via cookie; /jwt then exchanges that session for
+		// a token. Both requests must share a cookie jar.
+		jar, _ := cookiejar.New(nil)
+		httpClient.Jar = jar
+	}
+	return &JWTAuthenticator{Username: username, Password: password, baseURL: baseURL, httpClient: httpClient}
+}
+
+func (a *JWTAuthenticator) Apply(req *http.Request) error {
+	a.mu.Lock()
+	token := a.token
+	a.mu.Unlock()
+
+	if token == "" {
+		if err := a.Refresh(req.Context()); err != nil {
+			return err
+		}
+		a.mu.Lock()
+		token = a.token
+		a.mu.Unlock()
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+func (a *JWTAuthenticator) Refresh(ctx context.Context) error {
+	if err := a.login(ctx); err != nil {
+		return err
+	}
+
+	rel := &url.URL{Path: "/jwt"}
+	u := a.baseURL.ResolveReference(rel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/plain")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch reaper jwt: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch reaper jwt: unexpected status code %d", resp.StatusCode)
+	}
+
+	token, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read reaper jwt response: %w", err)
+	}
+
+	a.mu.Lock()
+	a.token = strings.TrimSpace(string(token))
+	a.mu.Unlock()
+
+	return nil
+}
+
+// login authenticates against Reaper's Shiro-backed /login endpoint, which establishes a session
+// cookie (captured by a.httpClient's cookie jar) rather than returning a token directly.
+func (a *JWTAuthenticator) login(ctx context.Context) error {
+	rel := &url.URL{Path: "/login"}
+	u := a.baseURL.ResolveReference(rel)
+
+	form := url.Values{}
+	form.Set("username", a.Username)
+	form.Set("password", a.Password)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to log in to reaper: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to log in to reaper: unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
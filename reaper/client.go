@@ -3,12 +3,16 @@ package reaper
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"math"
 	"net/http"
 	"net/url"
 	"runtime"
+	"strings"
 	"sync"
+	"time"
 )
 
 type ReaperClient interface {
@@ -17,7 +21,8 @@ type ReaperClient interface {
 	GetCluster(ctx context.Context, name string) (*Cluster, error)
 
 	// Fetches all clusters. This function is async and may return before any or all results are
-	// available. The concurrency is currently determined by min(5, NUM_CPUS).
+	// available. The concurrency is controlled by Client.Concurrency (see WithConcurrency),
+	// which defaults to min(5, NUM_CPUS).
 	GetClusters(ctx context.Context) <-chan GetClusterResult
 
 	// Fetches all clusters in a synchronous or blocking manner. Note that this function fails
@@ -27,25 +32,98 @@ type ReaperClient interface {
 	AddCluster(ctx context.Context, cluster string, seed string) error
 
 	DeleteCluster(ctx context.Context, cluster string) error
+
+	CreateRepairRun(ctx context.Context, cluster string, opts RepairRunOptions) (*RepairRun, error)
+
+	GetRepairRun(ctx context.Context, id string) (*RepairRun, error)
+
+	// Lists repair runs, optionally filtered by cluster, keyspace and/or state.
+	ListRepairRuns(ctx context.Context, opts ListRepairRunsOptions) ([]*RepairRun, error)
+
+	StartRepairRun(ctx context.Context, id string) error
+
+	PauseRepairRun(ctx context.Context, id string) error
+
+	ResumeRepairRun(ctx context.Context, id string) error
+
+	AbortRepairRun(ctx context.Context, id string) error
+
+	DeleteRepairRun(ctx context.Context, id string, owner string) error
+
+	GetRepairRunSegments(ctx context.Context, id string) ([]*RepairSegment, error)
+
+	CreateSchedule(ctx context.Context, cluster string, opts RepairScheduleOptions) (*RepairSchedule, error)
+
+	PauseSchedule(ctx context.Context, id string) error
+
+	ResumeSchedule(ctx context.Context, id string) error
+
+	DeleteSchedule(ctx context.Context, id string, owner string) error
+
+	ListSchedules(ctx context.Context, cluster string) ([]*RepairSchedule, error)
+
+	// WatchRepairRun polls the repair run on interval and emits an event whenever it changes.
+	// The returned channel closes when ctx is canceled, a terminal state (DONE/ABORTED/ERROR) is
+	// reached, or a poll fails.
+	WatchRepairRun(ctx context.Context, id string, interval time.Duration) <-chan RepairRunEvent
+
+	// WatchCluster polls the cluster on interval and emits a result whenever its gossip state
+	// changes. The returned channel closes when ctx is canceled or a poll fails.
+	WatchCluster(ctx context.Context, name string, interval time.Duration) <-chan GetClusterResult
+
+	TakeSnapshot(ctx context.Context, cluster string, opts SnapshotOptions) (*Snapshot, error)
+
+	// Takes a snapshot on every host in hosts (or, when empty, every host in the cluster). This
+	// function is async and may return before any or all results are available; concurrency is
+	// controlled by Client.Concurrency.
+	TakeSnapshots(ctx context.Context, cluster string, hosts []string, opts SnapshotOptions) <-chan SnapshotResult
+
+	ListSnapshots(ctx context.Context, cluster string, host string) ([]*Snapshot, error)
+
+	DeleteSnapshot(ctx context.Context, cluster string, host string, name string) error
+
+	DeleteSnapshotClusterWide(ctx context.Context, cluster string, name string) error
 }
 
 type Client struct {
-	BaseURL    *url.URL
-	UserAgent  string
+	BaseURL       *url.URL
+	UserAgent     string
+	RetryPolicy   RetryPolicy
+	Authenticator Authenticator
+
+	// Concurrency bounds the number of in-flight requests used by fan-out operations like
+	// GetClusters and TakeSnapshots. Defaults to min(5, NumCPU).
+	Concurrency int
+
 	httpClient *http.Client
 }
 
-func newClient(reaperBaseURL string) (*Client, error) {
-	if baseURL, err := url.Parse(reaperBaseURL); err != nil {
+func newClient(reaperBaseURL string, opts ...ClientOption) (*Client, error) {
+	baseURL, err := url.Parse(reaperBaseURL)
+	if err != nil {
 		return nil, err
-	} else {
-		return &Client{BaseURL: baseURL, UserAgent: "", httpClient: &http.Client{}}, nil
 	}
 
+	c := &Client{
+		BaseURL:     baseURL,
+		UserAgent:   "",
+		RetryPolicy: DefaultRetryPolicy(),
+		Concurrency: int(math.Min(5, float64(runtime.NumCPU()))),
+		httpClient:  &http.Client{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
 }
 
-func NewReaperClient(baseURL string) (ReaperClient, error) {
-	return newClient(baseURL)
+// NewReaperClient creates a Client for the Reaper instance at baseURL. Pass ClientOptions to
+// customize the underlying http.Client, authentication, user agent, fan-out concurrency, or
+// retry policy.
+func NewReaperClient(baseURL string, opts ...ClientOption) (ReaperClient, error) {
+	return newClient(baseURL, opts...)
 }
 
 func (c *Client) GetClusterNames(ctx context.Context) ([]string, error) {
@@ -56,8 +134,6 @@ func (c *Client) GetClusterNames(ctx context.Context) ([]string, error) {
 		return nil, err
 	}
 
-	//req.Header.Set("User-Agent", c.UserAgent)
-
 	clusterNames := []string{}
 	_, err = c.do(ctx, req, &clusterNames)
 
@@ -85,17 +161,14 @@ func (c *Client) GetCluster(ctx context.Context, name string) (*Cluster, error)
 
 	cluster := newCluster(clusterState)
 
-	// TODO check response status code
-
 	return cluster, nil
 }
 
 // Fetches all clusters. This function is async and may return before any or all results are
-// available. The concurrency is currently determined by min(5, NUM_CPUS).
+// available. The concurrency is controlled by Client.Concurrency (see WithConcurrency), which
+// defaults to min(5, NUM_CPUS).
 func (c *Client) GetClusters(ctx context.Context) <-chan GetClusterResult {
-	// TODO Make the concurrency configurable
-	concurrency := int(math.Min(5, float64(runtime.NumCPU())))
-	results := make(chan GetClusterResult, concurrency)
+	results := make(chan GetClusterResult, c.Concurrency)
 
 	clusterNames, err := c.GetClusterNames(ctx)
 	if err != nil {
@@ -104,6 +177,7 @@ func (c *Client) GetClusters(ctx context.Context) <-chan GetClusterResult {
 	}
 
 	var wg sync.WaitGroup
+	sem := make(chan struct{}, c.Concurrency)
 
 	go func() {
 		defer close(results)
@@ -111,9 +185,13 @@ func (c *Client) GetClusters(ctx context.Context) <-chan GetClusterResult {
 			wg.Add(1)
 			go func(name string) {
 				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
 				cluster, err := c.GetCluster(ctx, name)
-				result := GetClusterResult{Cluster: cluster, Error: err}
-				results <- result
+				select {
+				case results <- GetClusterResult{Cluster: cluster, Error: err}:
+				case <-ctx.Done():
+				}
 			}(clusterName)
 		}
 		wg.Wait()
@@ -141,28 +219,21 @@ func (c *Client) AddCluster(ctx context.Context, cluster string, seed string) er
 	rel := &url.URL{Path: fmt.Sprintf("/cluster/%s", cluster)}
 	u := c.BaseURL.ResolveReference(rel)
 
+	q := u.Query()
+	q.Set("seedHost", seed)
+	u.RawQuery = q.Encode()
+
 	req, err := http.NewRequest(http.MethodPut, u.String(), nil)
 	if err != nil {
 		return err
 	}
-	req.Header.Set("Accept", "application/json")
-	q := req.URL.Query()
-	q.Add("seedHost", seed)
-	req.URL.RawQuery = q.Encode()
-	req.WithContext(ctx)
 
-	resp, err := c.httpClient.Do(req)
+	_, err = c.do(ctx, req, nil)
+
 	if err != nil {
-		select {
-		case <- ctx.Done():
-			return ctx.Err()
-		default:
-		}
-		return err
+		return fmt.Errorf("failed to add cluster (%s): %w", cluster, err)
 	}
-	defer resp.Body.Close()
 
-	// TODO check status code
 	return nil
 }
 
@@ -176,8 +247,6 @@ func (c *Client) DeleteCluster(ctx context.Context, cluster string) error {
 
 	_, err = c.do(ctx, req, nil)
 
-	// TODO check response status code
-
 	if err != nil {
 		return fmt.Errorf("failed to delete cluster (%s): %w", cluster, err)
 	}
@@ -185,26 +254,104 @@ func (c *Client) DeleteCluster(ctx context.Context, cluster string) error {
 	return nil
 }
 
+// do sends req, transparently retrying according to c.RetryPolicy, and decodes the final
+// response body into v when v is non-nil, the body is non-empty and the response's Content-Type
+// is application/json; otherwise v is left untouched. GET/DELETE/HEAD requests are always eligible for
+// retry; POST/PUT/PATCH requests are only retried when c.RetryPolicy.RetryIdempotentWrites is
+// set, since Reaper treats some of those as non-idempotent (e.g. AddCluster uses PUT). When
+// c.Authenticator is set, it is applied to every attempt; an ErrUnauthorized response triggers a
+// single credential refresh (if the Authenticator supports it) followed by one more round of
+// attempts under the same retry policy.
+//
+// Any non-2xx response is returned as an *APIError (see errors.go), so callers can match it with
+// errors.Is against ErrNotFound, ErrConflict, ErrUnauthorized, ErrForbidden or ErrServer.
 func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
-	req.Header.Set("Accept", "application/json")
-	req.WithContext(ctx)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		select {
-		case <- ctx.Done():
-			return nil, ctx.Err()
-		default:
+	resp, err := c.doAttempts(ctx, req, v)
+	if errors.Is(err, ErrUnauthorized) {
+		if refresher, ok := c.Authenticator.(AuthRefresher); ok {
+			if rerr := refresher.Refresh(ctx); rerr == nil {
+				return c.doAttempts(ctx, req, v)
+			}
 		}
-		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if v != nil {
-		err = json.NewDecoder(resp.Body).Decode(v)
+	return resp, err
+}
+
+func (c *Client) doAttempts(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	policy := c.RetryPolicy
+	canRetry := isIdempotentMethod(req.Method) || policy.RetryIdempotentWrites
+
+	maxAttempts := 1
+	if canRetry {
+		maxAttempts = policy.MaxRetries + 1
 	}
 
-	return resp, err
+	var lastErr error
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			if err := sleepBackoff(ctx, policy, attempt); err != nil {
+				return nil, err
+			}
+		}
+
+		reqCopy := req.Clone(ctx)
+		reqCopy.Header.Set("Accept", "application/json")
+		if c.UserAgent != "" {
+			reqCopy.Header.Set("User-Agent", c.UserAgent)
+		}
+		if c.Authenticator != nil {
+			if err := c.Authenticator.Apply(reqCopy); err != nil {
+				return nil, fmt.Errorf("failed to apply authenticator: %w", err)
+			}
+		}
+
+		resp, err := c.httpClient.Do(reqCopy)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+
+			lastErr = err
+			if attempt < maxAttempts-1 && policy.classifier()(nil, err) {
+				continue
+			}
+			return nil, fmt.Errorf("request failed after %d attempt(s): %w", attempt+1, lastErr)
+		}
+
+		if attempt < maxAttempts-1 && policy.classifier()(resp, nil) {
+			resp.Body.Close()
+			lastErr = fmt.Errorf("received retryable status %d", resp.StatusCode)
+			continue
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return resp, newAPIError(resp, body)
+		}
+
+		if v != nil && len(body) > 0 && strings.Contains(resp.Header.Get("Content-Type"), "application/json") {
+			if err := json.Unmarshal(body, v); err != nil {
+				return resp, fmt.Errorf("failed to decode response body: %w", err)
+			}
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("request failed after %d attempt(s): %w", maxAttempts, lastErr)
+}
+
+func isIdempotentMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodDelete || method == http.MethodHead
 }
 
 func newCluster(state *clusterStatus) *Cluster {
@@ -0,0 +1,82 @@
+package reaper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors that callers can match against with errors.Is, e.g.
+// errors.Is(err, reaper.ErrNotFound). Client.do returns an *APIError wrapping the sentinel that
+// matches the response's status code.
+var (
+	ErrNotFound     = errors.New("reaper: not found")
+	ErrConflict     = errors.New("reaper: conflict")
+	ErrUnauthorized = errors.New("reaper: unauthorized")
+	ErrForbidden    = errors.New("reaper: forbidden")
+	ErrServer       = errors.New("reaper: server error")
+)
+
+// APIError is returned by Client.do for any non-2xx response. ReaperMessage holds Reaper's
+// {"message": "..."} payload when the body decodes as one.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Body       string
+
+	ReaperMessage string
+
+	sentinel error
+}
+
+func (e *APIError) Error() string {
+	msg := e.ReaperMessage
+	if msg == "" {
+		msg = e.Body
+	}
+	if msg == "" {
+		return fmt.Sprintf("%s %s: unexpected status code %d", e.Method, e.Path, e.StatusCode)
+	}
+	return fmt.Sprintf("%s %s: unexpected status code %d: %s", e.Method, e.Path, e.StatusCode, msg)
+}
+
+// Unwrap lets callers use errors.Is(err, reaper.ErrNotFound) and friends. It returns nil for
+// status codes with no matching sentinel.
+func (e *APIError) Unwrap() error {
+	return e.sentinel
+}
+
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     resp.Request.Method,
+		Path:       resp.Request.URL.Path,
+		Body:       string(body),
+	}
+
+	var payload struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &payload) == nil {
+		apiErr.ReaperMessage = payload.Message
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		apiErr.sentinel = ErrNotFound
+	case http.StatusConflict:
+		apiErr.sentinel = ErrConflict
+	case http.StatusUnauthorized:
+		apiErr.sentinel = ErrUnauthorized
+	case http.StatusForbidden:
+		apiErr.sentinel = ErrForbidden
+	default:
+		if resp.StatusCode >= http.StatusInternalServerError {
+			apiErr.sentinel = ErrServer
+		}
+	}
+
+	return apiErr
+}
@@ -0,0 +1,43 @@
+package reaper
+
+import "net/http"
+
+// ClientOption customizes a Client created by NewReaperClient.
+type ClientOption func(*Client)
+
+// WithHTTPClient overrides the http.Client used to talk to Reaper. Defaults to &http.Client{}.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithAuthenticator sets the Authenticator applied to every outgoing request. Defaults to nil,
+// which sends no credentials.
+func WithAuthenticator(authenticator Authenticator) ClientOption {
+	return func(c *Client) {
+		c.Authenticator = authenticator
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithConcurrency sets the number of in-flight requests used by fan-out operations like
+// GetClusters and TakeSnapshots. Defaults to min(5, NumCPU).
+func WithConcurrency(concurrency int) ClientOption {
+	return func(c *Client) {
+		c.Concurrency = concurrency
+	}
+}
+
+// WithRetryPolicy overrides the default RetryPolicy used by Client.do.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.RetryPolicy = policy
+	}
+}
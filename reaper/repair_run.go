@@ -0,0 +1,346 @@
+package reaper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RepairRunOptions holds the parameters accepted by POST /repair_run. Keyspace is required;
+// everything else is optional and only sent to Reaper when set.
+type RepairRunOptions struct {
+	Keyspace          string
+	Owner             string
+	Cause             string
+	Tables            []string
+	Nodes             []string
+	Datacenters       []string
+	BlacklistedTables []string
+	SegmentCount      int
+	RepairParallelism string
+	RepairThreadCount int
+	Intensity         float64
+	IncrementalRepair bool
+}
+
+// RepairScheduleOptions holds the parameters accepted by POST /repair_schedule. Keyspace and
+// ScheduleDaysBetween are required; everything else is optional and only sent to Reaper when set.
+type RepairScheduleOptions struct {
+	Keyspace            string
+	Owner               string
+	Tables              []string
+	Nodes               []string
+	Datacenters         []string
+	BlacklistedTables   []string
+	SegmentCount        int
+	RepairParallelism   string
+	RepairThreadCount   int
+	Intensity           float64
+	IncrementalRepair   bool
+	ScheduleDaysBetween int
+}
+
+func (c *Client) CreateRepairRun(ctx context.Context, cluster string, opts RepairRunOptions) (*RepairRun, error) {
+	rel := &url.URL{Path: "/repair_run"}
+	u := c.BaseURL.ResolveReference(rel)
+
+	q := u.Query()
+	q.Set("clusterName", cluster)
+	q.Set("keyspace", opts.Keyspace)
+	if opts.Owner != "" {
+		q.Set("owner", opts.Owner)
+	}
+	if opts.Cause != "" {
+		q.Set("cause", opts.Cause)
+	}
+	if len(opts.Tables) > 0 {
+		q.Set("tables", strings.Join(opts.Tables, ","))
+	}
+	if len(opts.Nodes) > 0 {
+		q.Set("nodes", strings.Join(opts.Nodes, ","))
+	}
+	if len(opts.Datacenters) > 0 {
+		q.Set("datacenters", strings.Join(opts.Datacenters, ","))
+	}
+	if len(opts.BlacklistedTables) > 0 {
+		q.Set("blacklistedTables", strings.Join(opts.BlacklistedTables, ","))
+	}
+	if opts.SegmentCount > 0 {
+		q.Set("segmentCount", strconv.Itoa(opts.SegmentCount))
+	}
+	if opts.RepairParallelism != "" {
+		q.Set("repairParallelism", opts.RepairParallelism)
+	}
+	if opts.RepairThreadCount > 0 {
+		q.Set("repairThreadCount", strconv.Itoa(opts.RepairThreadCount))
+	}
+	if opts.Intensity > 0 {
+		q.Set("intensity", strconv.FormatFloat(opts.Intensity, 'f', -1, 64))
+	}
+	if opts.IncrementalRepair {
+		q.Set("incrementalRepair", strconv.FormatBool(opts.IncrementalRepair))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	repairRun := &RepairRun{}
+	_, err = c.do(ctx, req, repairRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repair run (%s/%s): %w", cluster, opts.Keyspace, err)
+	}
+
+	return repairRun, nil
+}
+
+func (c *Client) GetRepairRun(ctx context.Context, id string) (*RepairRun, error) {
+	rel := &url.URL{Path: fmt.Sprintf("/repair_run/%s", id)}
+	u := c.BaseURL.ResolveReference(rel)
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	repairRun := &RepairRun{}
+	_, err = c.do(ctx, req, repairRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair run (%s): %w", id, err)
+	}
+
+	return repairRun, nil
+}
+
+// ListRepairRunsOptions filters the results of ListRepairRuns. All fields are optional; a zero
+// value means no filtering on that field.
+type ListRepairRunsOptions struct {
+	Cluster  string
+	Keyspace string
+	State    string
+}
+
+func (c *Client) ListRepairRuns(ctx context.Context, opts ListRepairRunsOptions) ([]*RepairRun, error) {
+	rel := &url.URL{Path: "/repair_run"}
+	u := c.BaseURL.ResolveReference(rel)
+
+	q := u.Query()
+	if opts.Cluster != "" {
+		q.Set("cluster_name", opts.Cluster)
+	}
+	if opts.Keyspace != "" {
+		q.Set("keyspace_name", opts.Keyspace)
+	}
+	if opts.State != "" {
+		q.Set("state", opts.State)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	repairRuns := make([]*RepairRun, 0)
+	_, err = c.do(ctx, req, &repairRuns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repair runs: %w", err)
+	}
+
+	return repairRuns, nil
+}
+
+func (c *Client) GetRepairRunSegments(ctx context.Context, id string) ([]*RepairSegment, error) {
+	rel := &url.URL{Path: fmt.Sprintf("/repair_run/%s/segments", id)}
+	u := c.BaseURL.ResolveReference(rel)
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]*RepairSegment, 0)
+	_, err = c.do(ctx, req, &segments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segments for repair run (%s): %w", id, err)
+	}
+
+	return segments, nil
+}
+
+func (c *Client) setRepairRunState(ctx context.Context, id string, state RepairRunState) error {
+	rel := &url.URL{Path: fmt.Sprintf("/repair_run/%s/state/%s", id, state)}
+	u := c.BaseURL.ResolveReference(rel)
+	req, err := http.NewRequest(http.MethodPut, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to set repair run (%s) state to %s: %w", id, state, err)
+	}
+
+	return nil
+}
+
+func (c *Client) StartRepairRun(ctx context.Context, id string) error {
+	return c.setRepairRunState(ctx, id, RepairRunStateRunning)
+}
+
+func (c *Client) PauseRepairRun(ctx context.Context, id string) error {
+	return c.setRepairRunState(ctx, id, RepairRunStatePaused)
+}
+
+func (c *Client) ResumeRepairRun(ctx context.Context, id string) error {
+	return c.setRepairRunState(ctx, id, RepairRunStateRunning)
+}
+
+func (c *Client) AbortRepairRun(ctx context.Context, id string) error {
+	return c.setRepairRunState(ctx, id, RepairRunStateAborted)
+}
+
+func (c *Client) DeleteRepairRun(ctx context.Context, id string, owner string) error {
+	rel := &url.URL{Path: fmt.Sprintf("/repair_run/%s", id)}
+	u := c.BaseURL.ResolveReference(rel)
+
+	q := u.Query()
+	q.Set("owner", owner)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete repair run (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func (c *Client) CreateSchedule(ctx context.Context, cluster string, opts RepairScheduleOptions) (*RepairSchedule, error) {
+	rel := &url.URL{Path: "/repair_schedule"}
+	u := c.BaseURL.ResolveReference(rel)
+
+	q := u.Query()
+	q.Set("clusterName", cluster)
+	q.Set("keyspace", opts.Keyspace)
+	q.Set("scheduleDaysBetween", strconv.Itoa(opts.ScheduleDaysBetween))
+	if opts.Owner != "" {
+		q.Set("owner", opts.Owner)
+	}
+	if len(opts.Tables) > 0 {
+		q.Set("tables", strings.Join(opts.Tables, ","))
+	}
+	if len(opts.Nodes) > 0 {
+		q.Set("nodes", strings.Join(opts.Nodes, ","))
+	}
+	if len(opts.Datacenters) > 0 {
+		q.Set("datacenters", strings.Join(opts.Datacenters, ","))
+	}
+	if len(opts.BlacklistedTables) > 0 {
+		q.Set("blacklistedTables", strings.Join(opts.BlacklistedTables, ","))
+	}
+	if opts.SegmentCount > 0 {
+		q.Set("segmentCount", strconv.Itoa(opts.SegmentCount))
+	}
+	if opts.RepairParallelism != "" {
+		q.Set("repairParallelism", opts.RepairParallelism)
+	}
+	if opts.RepairThreadCount > 0 {
+		q.Set("repairThreadCount", strconv.Itoa(opts.RepairThreadCount))
+	}
+	if opts.Intensity > 0 {
+		q.Set("intensity", strconv.FormatFloat(opts.Intensity, 'f', -1, 64))
+	}
+	if opts.IncrementalRepair {
+		q.Set("incrementalRepair", strconv.FormatBool(opts.IncrementalRepair))
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &RepairSchedule{}
+	_, err = c.do(ctx, req, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repair schedule (%s/%s): %w", cluster, opts.Keyspace, err)
+	}
+
+	return schedule, nil
+}
+
+func (c *Client) setScheduleState(ctx context.Context, id string, state string) error {
+	rel := &url.URL{Path: fmt.Sprintf("/repair_schedule/%s", id)}
+	u := c.BaseURL.ResolveReference(rel)
+
+	q := u.Query()
+	q.Set("state", state)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodPut, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to set repair schedule (%s) state to %s: %w", id, state, err)
+	}
+
+	return nil
+}
+
+func (c *Client) PauseSchedule(ctx context.Context, id string) error {
+	return c.setScheduleState(ctx, id, "PAUSED")
+}
+
+func (c *Client) ResumeSchedule(ctx context.Context, id string) error {
+	return c.setScheduleState(ctx, id, "ACTIVE")
+}
+
+func (c *Client) DeleteSchedule(ctx context.Context, id string, owner string) error {
+	rel := &url.URL{Path: fmt.Sprintf("/repair_schedule/%s", id)}
+	u := c.BaseURL.ResolveReference(rel)
+
+	q := u.Query()
+	q.Set("owner", owner)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest(http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete repair schedule (%s): %w", id, err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListSchedules(ctx context.Context, cluster string) ([]*RepairSchedule, error) {
+	rel := &url.URL{Path: "/repair_schedule/cluster/" + cluster}
+	u := c.BaseURL.ResolveReference(rel)
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]*RepairSchedule, 0)
+	_, err = c.do(ctx, req, &schedules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repair schedules for cluster (%s): %w", cluster, err)
+	}
+
+	return schedules, nil
+}
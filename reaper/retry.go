@@ -0,0 +1,89 @@
+package reaper
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy controls how Client.do retries a failed request. The delay before attempt n
+// (n >= 1) is full-jitter exponential backoff: a random duration in [0, min(MaxBackoff,
+// MinBackoff*2^(n-1))].
+type RetryPolicy struct {
+	// MaxRetries is the number of retries attempted in addition to the initial request. Zero
+	// disables retries entirely.
+	MaxRetries int
+
+	MinBackoff time.Duration
+
+	MaxBackoff time.Duration
+
+	// RetryIdempotentWrites opts POST/PUT/PATCH requests into retries. GET/DELETE/HEAD requests
+	// are always eligible. Reaper's AddCluster uses PUT but is not safe to retry blindly, so
+	// this defaults to false.
+	RetryIdempotentWrites bool
+
+	// RetryClassifier decides whether a completed attempt (resp, err - exactly one is non-nil)
+	// should be retried. When nil, DefaultRetryClassifier is used.
+	RetryClassifier func(resp *http.Response, err error) bool
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 5 * time.Second,
+	}
+}
+
+func (p RetryPolicy) classifier() func(*http.Response, error) bool {
+	if p.RetryClassifier != nil {
+		return p.RetryClassifier
+	}
+	return DefaultRetryClassifier
+}
+
+// DefaultRetryClassifier retries 502/503/504 responses and any error that is a net.Error.
+// http.Client.Do returns every error as a *url.Error, which implements net.Error, so all
+// transport errors are retried, not only connection failures. A ctx
+// cancellation or deadline is never passed here: doAttempts returns ctx.Err() directly once
+// ctx.Done() fires, without consulting the classifier.
+func DefaultRetryClassifier(resp *http.Response, err error) bool {
+	if err != nil {
+		var netErr net.Error
+		return errors.As(err, &netErr)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if max := float64(p.MaxBackoff); delay > max {
+		delay = max
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// sleepBackoff sleeps for the policy's backoff duration for the given attempt number (the
+// attempt about to be made, 1-based), returning ctx.Err() if ctx is canceled first.
+func sleepBackoff(ctx context.Context, p RetryPolicy, attempt int) error {
+	timer := time.NewTimer(p.backoff(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,246 @@
+package reaper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Snapshot represents a Cassandra snapshot taken through Reaper's /snapshot endpoints.
+type Snapshot struct {
+	Cluster           string   `json:"clusterName"`
+	Host              string   `json:"host,omitempty"`
+	Name              string   `json:"name"`
+	Owner             string   `json:"owner,omitempty"`
+	Cause             string   `json:"cause,omitempty"`
+	Keyspaces         []string `json:"keyspaces,omitempty"`
+	Tables            []string `json:"tables,omitempty"`
+	CreationTime      string   `json:"creationTime,omitempty"`
+	SizeOnDiskInBytes int64    `json:"sizeOnDiskInBytes,omitempty"`
+	TrueSizeInBytes   int64    `json:"trueSizeInBytes,omitempty"`
+}
+
+// SnapshotResult is the result of taking a snapshot on a single host, returned by TakeSnapshots.
+type SnapshotResult struct {
+	Snapshot *Snapshot
+	Error    error
+}
+
+// SnapshotOptions holds the parameters accepted by POST /snapshot. Host targets a single node;
+// when empty TakeSnapshot takes the snapshot cluster-wide. TakeSnapshots ignores Host and sets it
+// to each host it targets in turn.
+type SnapshotOptions struct {
+	Host        string
+	Keyspaces   []string
+	Tables      []string
+	Name        string
+	Owner       string
+	Cause       string
+	Datacenters []string
+}
+
+func (opts SnapshotOptions) query() url.Values {
+	q := url.Values{}
+	if opts.Name != "" {
+		q.Set("snapshot_name", opts.Name)
+	}
+	if opts.Owner != "" {
+		q.Set("owner", opts.Owner)
+	}
+	if opts.Cause != "" {
+		q.Set("cause", opts.Cause)
+	}
+	if len(opts.Keyspaces) > 0 {
+		q.Set("keyspace", strings.Join(opts.Keyspaces, ","))
+	}
+	if len(opts.Tables) > 0 {
+		q.Set("tables", strings.Join(opts.Tables, ","))
+	}
+	if len(opts.Datacenters) > 0 {
+		q.Set("datacenters", strings.Join(opts.Datacenters, ","))
+	}
+	return q
+}
+
+// TakeSnapshot takes a snapshot of cluster. When opts.Host is set the snapshot is taken on that
+// node only; otherwise it is taken cluster-wide.
+func (c *Client) TakeSnapshot(ctx context.Context, cluster string, opts SnapshotOptions) (*Snapshot, error) {
+	var rel *url.URL
+	if opts.Host != "" {
+		rel = &url.URL{Path: fmt.Sprintf("/snapshot/%s/%s", cluster, opts.Host)}
+	} else {
+		rel = &url.URL{Path: fmt.Sprintf("/snapshot/cluster/%s", cluster)}
+	}
+	u := c.BaseURL.ResolveReference(rel)
+	u.RawQuery = opts.query().Encode()
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{}
+	_, err = c.do(ctx, req, snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take snapshot of cluster (%s): %w", cluster, err)
+	}
+
+	return snapshot, nil
+}
+
+// TakeSnapshots takes a snapshot on every host in hosts (or, when empty, every host resolved
+// from the cluster's current gossip state) using the same bounded-concurrency worker pool
+// pattern as GetClusters, with concurrency controlled by Client.Concurrency.
+func (c *Client) TakeSnapshots(ctx context.Context, cluster string, hosts []string, opts SnapshotOptions) <-chan SnapshotResult {
+	results := make(chan SnapshotResult, c.Concurrency)
+
+	if len(hosts) == 0 {
+		resolved, err := c.clusterHosts(ctx, cluster)
+		if err != nil {
+			results <- SnapshotResult{Error: err}
+			close(results)
+			return results
+		}
+		hosts = resolved
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, c.Concurrency)
+
+	go func() {
+		defer close(results)
+		for _, host := range hosts {
+			wg.Add(1)
+			go func(host string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				hostOpts := opts
+				hostOpts.Host = host
+				snapshot, err := c.TakeSnapshot(ctx, cluster, hostOpts)
+				select {
+				case results <- SnapshotResult{Snapshot: snapshot, Error: err}:
+				case <-ctx.Done():
+				}
+			}(host)
+		}
+		wg.Wait()
+	}()
+
+	return results
+}
+
+// clusterHosts returns the set of endpoints reported in cluster's gossip state.
+func (c *Client) clusterHosts(ctx context.Context, cluster string) ([]string, error) {
+	clusterState, err := c.GetCluster(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve hosts for cluster (%s): %w", cluster, err)
+	}
+
+	var hosts []string
+	seen := make(map[string]bool)
+	for _, gossipState := range clusterState.NodeState.GossipStates {
+		for _, host := range gossipState.EndpointNames {
+			if !seen[host] {
+				seen[host] = true
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	return hosts, nil
+}
+
+// ListSnapshots lists the snapshots present on host. When host is empty it lists snapshots
+// across the whole cluster.
+func (c *Client) ListSnapshots(ctx context.Context, cluster string, host string) ([]*Snapshot, error) {
+	if host != "" {
+		return c.listHostSnapshots(ctx, cluster, host)
+	}
+
+	rel := &url.URL{Path: fmt.Sprintf("/snapshot/cluster/%s", cluster)}
+	u := c.BaseURL.ResolveReference(rel)
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*Snapshot, 0)
+	_, err = c.do(ctx, req, &snapshots)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots for cluster (%s): %w", cluster, err)
+	}
+
+	return snapshots, nil
+}
+
+// listHostSnapshots handles GET /snapshot/{cluster}/{host}, which Reaper returns as a JSON
+// object keyed by snapshot name (one entry per keyspace/table captured under that name) rather
+// than a top-level array.
+func (c *Client) listHostSnapshots(ctx context.Context, cluster string, host string) ([]*Snapshot, error) {
+	rel := &url.URL{Path: fmt.Sprintf("/snapshot/%s/%s", cluster, host)}
+	u := c.BaseURL.ResolveReference(rel)
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string][]*Snapshot)
+	_, err = c.do(ctx, req, &byName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots on host (%s) of cluster (%s): %w", host, cluster, err)
+	}
+
+	snapshots := make([]*Snapshot, 0)
+	for name, entries := range byName {
+		for _, snapshot := range entries {
+			if snapshot.Name == "" {
+				snapshot.Name = name
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	return snapshots, nil
+}
+
+// DeleteSnapshot deletes the named snapshot from a single host.
+func (c *Client) DeleteSnapshot(ctx context.Context, cluster string, host string, name string) error {
+	rel := &url.URL{Path: fmt.Sprintf("/snapshot/%s/%s/%s", cluster, host, name)}
+	u := c.BaseURL.ResolveReference(rel)
+
+	req, err := http.NewRequest(http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete snapshot (%s) on host (%s): %w", name, host, err)
+	}
+
+	return nil
+}
+
+// DeleteSnapshotClusterWide deletes the named snapshot from every node in cluster.
+func (c *Client) DeleteSnapshotClusterWide(ctx context.Context, cluster string, name string) error {
+	rel := &url.URL{Path: fmt.Sprintf("/snapshot/cluster/%s/%s", cluster, name)}
+	u := c.BaseURL.ResolveReference(rel)
+
+	req, err := http.NewRequest(http.MethodDelete, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete snapshot (%s) cluster-wide (%s): %w", name, cluster, err)
+	}
+
+	return nil
+}
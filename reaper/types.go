@@ -47,11 +47,77 @@ type GetClusterResult struct {
 }
 
 type RepairRun struct {
-	Cluster string `json:"clusterName"`
+	Id                string   `json:"id"`
+	Cluster           string   `json:"clusterName"`
+	Keyspace          string   `json:"keyspace"`
+	Owner             string   `json:"owner"`
+	Cause             string   `json:"cause"`
+	State             string   `json:"state"`
+	Tables            []string `json:"tables,omitempty"`
+	Nodes             []string `json:"nodes,omitempty"`
+	Datacenters       []string `json:"datacenters,omitempty"`
+	BlacklistedTables []string `json:"blacklistedTables,omitempty"`
+	SegmentCount      int      `json:"segmentCount"`
+	RepairParallelism string   `json:"repairParallelism"`
+	RepairThreadCount int      `json:"repairThreadCount"`
+	Intensity         float64  `json:"intensity"`
+	IncrementalRepair bool     `json:"incrementalRepair"`
+	TotalSegments     int      `json:"totalSegments"`
+	SegmentsRepaired  int      `json:"segmentsRepaired"`
+	CreationTime      string   `json:"creationTime,omitempty"`
+	StartTime         string   `json:"startTime,omitempty"`
+	EndTime           string   `json:"endTime,omitempty"`
+	PauseTime         string   `json:"pauseTime,omitempty"`
+	Duration          string   `json:"duration,omitempty"`
+	LastEvent         string   `json:"lastEvent,omitempty"`
+}
+
+// RepairRunState enumerates the values Reaper uses for RepairRun.State and the state transitions
+// accepted by PUT /repair_run/{id}/state/{state}.
+type RepairRunState string
 
-	Keyspace string `json:"keyspace"`
+const (
+	RepairRunStateNotStarted RepairRunState = "NOT_STARTED"
+	RepairRunStateRunning    RepairRunState = "RUNNING"
+	RepairRunStatePaused     RepairRunState = "PAUSED"
+	RepairRunStateDone       RepairRunState = "DONE"
+	RepairRunStateError      RepairRunState = "ERROR"
+	RepairRunStateAborted    RepairRunState = "ABORTED"
+	RepairRunStateDeleted    RepairRunState = "DELETED"
+)
+
+// RepairSegment represents a single segment of a RepairRun as returned by
+// GET /repair_run/{id}/segments.
+type RepairSegment struct {
+	Id          string            `json:"id"`
+	RepairRunId string            `json:"repairRunId"`
+	StartToken  string            `json:"startToken"`
+	EndToken    string            `json:"endToken"`
+	State       string            `json:"state"`
+	FailCount   int               `json:"failCount"`
+	Replicas    map[string]string `json:"replicas,omitempty"`
+}
 
-	Owner string `json:"owner"`
+// RepairSchedule represents a recurring RepairRun definition as returned by the
+// /repair_schedule endpoints.
+type RepairSchedule struct {
+	Id                   string   `json:"id"`
+	Cluster              string   `json:"clusterName"`
+	Keyspace             string   `json:"keyspace"`
+	Owner                string   `json:"owner"`
+	State                string   `json:"state"`
+	Tables               []string `json:"tables,omitempty"`
+	Nodes                []string `json:"nodes,omitempty"`
+	Datacenters          []string `json:"datacenters,omitempty"`
+	BlacklistedTables    []string `json:"blacklistedTables,omitempty"`
+	SegmentCount         int      `json:"segmentCount"`
+	RepairParallelism    string   `json:"repairParallelism"`
+	RepairThreadCount    int      `json:"repairThreadCount"`
+	Intensity            float64  `json:"intensity"`
+	IncrementalRepair    bool     `json:"incrementalRepair"`
+	ScheduledDaysBetween int      `json:"scheduleDaysBetween"`
+	NextActivation       string   `json:"nextActivation,omitempty"`
+	RunHistory           []string `json:"runHistory,omitempty"`
 }
 
 // All the following types are used internally by the client and not part of the public API
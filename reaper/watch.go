@@ -0,0 +1,130 @@
+package reaper
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// RepairRunEvent is emitted by WatchRepairRun whenever the observed RepairRun changes, or when
+// polling fails.
+type RepairRunEvent struct {
+	RepairRun *RepairRun
+	Error     error
+}
+
+// WatchRepairRun polls GetRepairRun on interval and emits a RepairRunEvent whenever the run
+// changes (state transitions, segments completed, or any other field changing). Identical
+// consecutive snapshots are coalesced and do not produce an event. The channel closes when ctx
+// is canceled, a terminal state (DONE/ABORTED/ERROR) is reached, or a poll fails.
+func (c *Client) WatchRepairRun(ctx context.Context, id string, interval time.Duration) <-chan RepairRunEvent {
+	events := make(chan RepairRunEvent, 1)
+
+	if interval <= 0 {
+		events <- RepairRunEvent{Error: fmt.Errorf("watch interval must be positive, got %s", interval)}
+		close(events)
+		return events
+	}
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var prev *RepairRun
+
+		for {
+			run, err := c.GetRepairRun(ctx, id)
+			if err != nil {
+				select {
+				case events <- RepairRunEvent{Error: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			if prev == nil || !reflect.DeepEqual(prev, run) {
+				select {
+				case events <- RepairRunEvent{RepairRun: run}:
+				case <-ctx.Done():
+					return
+				}
+				prev = run
+			}
+
+			if isTerminalRepairRunState(run.State) {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+func isTerminalRepairRunState(state string) bool {
+	switch RepairRunState(state) {
+	case RepairRunStateDone, RepairRunStateAborted, RepairRunStateError:
+		return true
+	default:
+		return false
+	}
+}
+
+// WatchCluster polls GetCluster on interval and emits a GetClusterResult whenever the cluster's
+// gossip state changes (endpoint status, new/removed endpoints, load deltas, etc). Identical
+// consecutive snapshots are coalesced and do not produce an event. The channel closes when ctx
+// is canceled or a poll fails.
+func (c *Client) WatchCluster(ctx context.Context, name string, interval time.Duration) <-chan GetClusterResult {
+	results := make(chan GetClusterResult, 1)
+
+	if interval <= 0 {
+		results <- GetClusterResult{Error: fmt.Errorf("watch interval must be positive, got %s", interval)}
+		close(results)
+		return results
+	}
+
+	go func() {
+		defer close(results)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var prev *Cluster
+
+		for {
+			cluster, err := c.GetCluster(ctx, name)
+			if err != nil {
+				select {
+				case results <- GetClusterResult{Error: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			if prev == nil || !reflect.DeepEqual(prev, cluster) {
+				select {
+				case results <- GetClusterResult{Cluster: cluster}:
+				case <-ctx.Done():
+					return
+				}
+				prev = cluster
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return results
+}